@@ -24,10 +24,23 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	gardenScheme = runtime.NewScheme()
+	// gardenDecoder is stateless and hence shared between all callers instead of being
+	// reconstructed for every decoded Cluster resource.
+	gardenDecoder runtime.Decoder
+)
+
+func init() {
+	utilruntime.Must(gardenv1beta1.AddToScheme(gardenScheme))
+	gardenDecoder = serializer.NewCodecFactory(gardenScheme).UniversalDecoder()
+}
+
 // Cluster contains the decoded resources of Gardener's extension Cluster resource.
 // TODO: Change from `gardenv1beta1` to `gardencorev1alpha1` once we have moved the resources there.
 type Cluster struct {
@@ -61,37 +74,22 @@ func GetCluster(ctx context.Context, c client.Client, namespace string) (*Cluste
 
 // CloudProfileFromCluster returns the CloudProfile resource inside the Cluster resource.
 func CloudProfileFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.CloudProfile, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	cloudProfile := &gardenv1beta1.CloudProfile{}
-	_, _, err = decoder.Decode(cluster.Spec.CloudProfile.Raw, nil, cloudProfile)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.CloudProfile.Raw, nil, cloudProfile)
 	return cloudProfile, err
 }
 
 // SeedFromCluster returns the Seed resource inside the Cluster resource.
 func SeedFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.Seed, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	seed := &gardenv1beta1.Seed{}
-	_, _, err = decoder.Decode(cluster.Spec.Seed.Raw, nil, seed)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.Seed.Raw, nil, seed)
 	return seed, err
 }
 
 // ShootFromCluster returns the Shoot resource inside the Cluster resource.
 func ShootFromCluster(cluster *extensionsv1alpha1.Cluster) (*gardenv1beta1.Shoot, error) {
-	decoder, err := newGardenDecoder()
-	if err != nil {
-		return nil, err
-	}
-
 	shoot := &gardenv1beta1.Shoot{}
-	_, _, err = decoder.Decode(cluster.Spec.Shoot.Raw, nil, shoot)
+	_, _, err := gardenDecoder.Decode(cluster.Spec.Shoot.Raw, nil, shoot)
 	return shoot, err
 }
 
@@ -100,9 +98,3 @@ func ShootIsFailed(shoot *gardenv1beta1.Shoot) bool {
 	lastOperation := shoot.Status.LastOperation
 	return lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateFailed && shoot.Generation == shoot.Status.ObservedGeneration
 }
-
-func newGardenDecoder() (runtime.Decoder, error) {
-	scheme := runtime.NewScheme()
-	decoder := serializer.NewCodecFactory(scheme).UniversalDecoder()
-	return decoder, gardenv1beta1.AddToScheme(scheme)
-}
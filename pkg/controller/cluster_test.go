@@ -0,0 +1,112 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+func newClusterWithShoot(shoot *gardenv1beta1.Shoot) *extensionsv1alpha1.Cluster {
+	raw, err := json.Marshal(shoot)
+	if err != nil {
+		panic(err)
+	}
+
+	return &extensionsv1alpha1.Cluster{
+		Spec: extensionsv1alpha1.ClusterSpec{
+			Shoot: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+var _ = Describe("Cluster", func() {
+	Describe("#ShootFromCluster", func() {
+		It("should decode the shoot embedded in the cluster", func() {
+			cluster := newClusterWithShoot(&gardenv1beta1.Shoot{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: gardenv1beta1.SchemeGroupVersion.String(),
+					Kind:       "Shoot",
+				},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			})
+
+			shoot, err := ShootFromCluster(cluster)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoot.Name).To(Equal("foo"))
+		})
+
+		It("should fail if the embedded shoot cannot be decoded", func() {
+			cluster := &extensionsv1alpha1.Cluster{
+				Spec: extensionsv1alpha1.ClusterSpec{
+					Shoot: runtime.RawExtension{Raw: []byte("{")},
+				},
+			}
+
+			_, err := ShootFromCluster(cluster)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+func benchmarkCluster() *extensionsv1alpha1.Cluster {
+	return newClusterWithShoot(&gardenv1beta1.Shoot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gardenv1beta1.SchemeGroupVersion.String(),
+			Kind:       "Shoot",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+	})
+}
+
+func BenchmarkShootFromCluster(b *testing.B) {
+	cluster := benchmarkCluster()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ShootFromCluster(cluster); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkShootFromClusterWithNewDecoder constructs the decoder per call like ShootFromCluster
+// used to do and serves as baseline for BenchmarkShootFromCluster.
+func BenchmarkShootFromClusterWithNewDecoder(b *testing.B) {
+	cluster := benchmarkCluster()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scheme := runtime.NewScheme()
+		if err := gardenv1beta1.AddToScheme(scheme); err != nil {
+			b.Fatal(err)
+		}
+		decoder := serializer.NewCodecFactory(scheme).UniversalDecoder()
+
+		if _, _, err := decoder.Decode(cluster.Spec.Shoot.Raw, nil, &gardenv1beta1.Shoot{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
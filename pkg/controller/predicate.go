@@ -16,6 +16,8 @@ package controller
 
 import (
 	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -96,6 +98,28 @@ func AnnotationsChangedPredicate() predicate.Predicate {
 	return annotationsChangedPredicate
 }
 
+var hasOperationAnnotationPredicate = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return hasOperationAnnotation(e.Meta)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return hasOperationAnnotation(e.MetaNew)
+	},
+	GenericFunc: func(e event.GenericEvent) bool {
+		return hasOperationAnnotation(e.Meta)
+	},
+}
+
+// HasOperationAnnotationPredicate is a predicate for the operation annotation being set to 'reconcile'.
+// Delete events always pass so that the finalizer handling is not skipped.
+func HasOperationAnnotationPredicate() predicate.Predicate {
+	return hasOperationAnnotationPredicate
+}
+
+func hasOperationAnnotation(meta metav1.Object) bool {
+	return meta != nil && meta.GetAnnotations()[gardencorev1alpha1.GardenerOperation] == gardencorev1alpha1.GardenerOperationReconcile
+}
+
 // OrPredicate is a predicate for annotations changes.
 func OrPredicate(predicates ...predicate.Predicate) predicate.Predicate {
 	orRange := func(f func(predicate.Predicate) bool) bool {
@@ -122,3 +146,30 @@ func OrPredicate(predicates ...predicate.Predicate) predicate.Predicate {
 		},
 	}
 }
+
+// AndPredicate is a predicate that matches if all of the given predicates match.
+func AndPredicate(predicates ...predicate.Predicate) predicate.Predicate {
+	andRange := func(f func(predicate.Predicate) bool) bool {
+		for _, p := range predicates {
+			if !f(p) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(event event.CreateEvent) bool {
+			return andRange(func(p predicate.Predicate) bool { return p.Create(event) })
+		},
+		UpdateFunc: func(event event.UpdateEvent) bool {
+			return andRange(func(p predicate.Predicate) bool { return p.Update(event) })
+		},
+		DeleteFunc: func(event event.DeleteEvent) bool {
+			return andRange(func(p predicate.Predicate) bool { return p.Delete(event) })
+		},
+		GenericFunc: func(event event.GenericEvent) bool {
+			return andRange(func(p predicate.Predicate) bool { return p.Generic(event) })
+		},
+	}
+}
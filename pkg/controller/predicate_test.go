@@ -0,0 +1,111 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+func computeEvents(obj *corev1.ConfigMap) (event.CreateEvent, event.UpdateEvent, event.DeleteEvent, event.GenericEvent) {
+	return event.CreateEvent{Meta: obj, Object: obj},
+		event.UpdateEvent{MetaOld: obj, ObjectOld: obj, MetaNew: obj, ObjectNew: obj},
+		event.DeleteEvent{Meta: obj, Object: obj},
+		event.GenericEvent{Meta: obj, Object: obj}
+}
+
+var _ = Describe("Predicate", func() {
+	Describe("#HasOperationAnnotationPredicate", func() {
+		It("should match if the operation annotation is set to reconcile", func() {
+			obj := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						gardencorev1alpha1.GardenerOperation: gardencorev1alpha1.GardenerOperationReconcile,
+					},
+				},
+			}
+			createEvent, updateEvent, deleteEvent, genericEvent := computeEvents(obj)
+			predicate := HasOperationAnnotationPredicate()
+
+			Expect(predicate.Create(createEvent)).To(BeTrue())
+			Expect(predicate.Update(updateEvent)).To(BeTrue())
+			Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+			Expect(predicate.Generic(genericEvent)).To(BeTrue())
+		})
+
+		It("should only match delete events if the operation annotation is missing", func() {
+			createEvent, updateEvent, deleteEvent, genericEvent := computeEvents(&corev1.ConfigMap{})
+			predicate := HasOperationAnnotationPredicate()
+
+			Expect(predicate.Create(createEvent)).To(BeFalse())
+			Expect(predicate.Update(updateEvent)).To(BeFalse())
+			Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+			Expect(predicate.Generic(genericEvent)).To(BeFalse())
+		})
+
+		It("should only match delete events if the operation annotation has a different value", func() {
+			obj := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						gardencorev1alpha1.GardenerOperation: "foo",
+					},
+				},
+			}
+			createEvent, updateEvent, deleteEvent, genericEvent := computeEvents(obj)
+			predicate := HasOperationAnnotationPredicate()
+
+			Expect(predicate.Create(createEvent)).To(BeFalse())
+			Expect(predicate.Update(updateEvent)).To(BeFalse())
+			Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+			Expect(predicate.Generic(genericEvent)).To(BeFalse())
+		})
+	})
+
+	Describe("#AndPredicate", func() {
+		It("should match if all predicates match", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+			updateEvent := event.UpdateEvent{
+				MetaOld:   &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+				ObjectOld: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Generation: 1}},
+				MetaNew:   obj,
+				ObjectNew: obj,
+			}
+
+			Expect(AndPredicate(GenerationChangedPredicate(), predicate.Funcs{}).Update(updateEvent)).To(BeTrue())
+		})
+
+		It("should not match if any predicate does not match", func() {
+			obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Generation: 1}}
+			_, updateEvent, _, _ := computeEvents(obj)
+
+			Expect(AndPredicate(predicate.Funcs{}, GenerationChangedPredicate()).Update(updateEvent)).To(BeFalse())
+		})
+
+		It("should match if no predicates are given", func() {
+			createEvent, updateEvent, deleteEvent, genericEvent := computeEvents(&corev1.ConfigMap{})
+			predicate := AndPredicate()
+
+			Expect(predicate.Create(createEvent)).To(BeTrue())
+			Expect(predicate.Update(updateEvent)).To(BeTrue())
+			Expect(predicate.Delete(deleteEvent)).To(BeTrue())
+			Expect(predicate.Generic(genericEvent)).To(BeTrue())
+		})
+	})
+})